@@ -1,6 +1,7 @@
 package mighty
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"path/filepath"
@@ -8,6 +9,10 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/pmezard/go-difflib/difflib"
 )
 
 var packageName = reflect.TypeOf(Myt{}).PkgPath() // e.g. "github.com/icza/mighty"
@@ -45,11 +50,13 @@ func (m Myt) Near(exp, got, eps float64, errs ...error) {
 // only takes the 'got' value and an optional error.
 //
 // The following multiline code:
-//     got, err := SomeFunc()
-//     Eq(exp, got, err)
+//
+//	got, err := SomeFunc()
+//	Eq(exp, got, err)
 //
 // Is equivalent to this single line:
-//     ExpEq(exp)(SomeFunc())
+//
+//	ExpEq(exp)(SomeFunc())
 func (m Myt) ExpEq(exp interface{}) func(got interface{}, errs ...error) {
 	return m.expEqDeq(exp, false)
 }
@@ -58,11 +65,13 @@ func (m Myt) ExpEq(exp interface{}) func(got interface{}, errs ...error) {
 // only takes the 'got' value and an optional error.
 //
 // The following multiline code:
-//     got, err := SomeFunc()
-//     Deq(exp, got, err)
+//
+//	got, err := SomeFunc()
+//	Deq(exp, got, err)
 //
 // Is equivalent to this single line:
-//     ExpDeq(exp)(SomeFunc())
+//
+//	ExpDeq(exp)(SomeFunc())
 func (m Myt) ExpDeq(exp interface{}) func(got interface{}, errs ...error) {
 	return m.expEqDeq(exp, true)
 }
@@ -100,18 +109,52 @@ func (m Myt) expEqDeq(exp interface{}, deep bool) func(got interface{}, errs ...
 				m.Errorf("\tTypes of expected and got do not match! exp type: %v, got type: %v", texp, tgot)
 			}
 		}
+		// For deep equality checks, a one-line %v dump is often unreadable
+		// (nested structs, maps, long slices), so also append a detailed diff.
+		if deep && !eq {
+			m.Errorf("\tDiff:\n%s", DiffLogic(exp, got))
+		}
 	}
 }
 
+// DiffLogic is a variable holding a function which is responsible for
+// producing a human-readable diff of the expected and got values when a
+// Deq / ExpDeq check fails. Default value is DiffFunc, but you may set
+// your own function.
+var DiffLogic func(exp, got interface{}) string = DiffFunc
+
+// DiffFunc renders exp and got with go-spew (so nested structs, maps and
+// pointers are fully expanded) and returns a unified diff between the two
+// dumps. This is the default DiffLogic, but you may set your own function.
+func DiffFunc(exp, got interface{}) string {
+	expDump, gotDump := spew.Sdump(exp), spew.Sdump(got)
+
+	diff := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(expDump),
+		B:        difflib.SplitLines(gotDump),
+		FromFile: "Expected",
+		ToFile:   "Got",
+		Context:  3,
+	}
+
+	text, err := difflib.GetUnifiedDiffString(diff)
+	if err != nil {
+		return fmt.Sprintf("(failed to compute diff: %v)", err)
+	}
+	return text
+}
+
 // ExpNeq takes one value and returns a function which
 // takes only the 2nd value and an optional error.
 //
 // The following multiline code:
-//     v2, err := SomeFunc()
-//     Neq(v1, v2, err)
+//
+//	v2, err := SomeFunc()
+//	Neq(v1, v2, err)
 //
 // Is equivalent to this single line:
-//     ExpNeq(v1)(SomeFunc())
+//
+//	ExpNeq(v1)(SomeFunc())
 func (m Myt) ExpNeq(v1 interface{}) func(v2 interface{}, errs ...error) {
 	return func(v2 interface{}, errs ...error) {
 		err := getErr(errs...)
@@ -131,11 +174,13 @@ func (m Myt) ExpNeq(v1 interface{}) func(v2 interface{}, errs ...error) {
 // only takes the 'got' value and an optional error.
 //
 // The following multiline code:
-//     got, err := SomeFunc()
-//     Near(exp, got, eps, err)
+//
+//	got, err := SomeFunc()
+//	Near(exp, got, eps, err)
 //
 // Is equivalent to this single line:
-//     ExpNear(exp, eps)(SomeFunc())
+//
+//	ExpNear(exp, eps)(SomeFunc())
 func (m Myt) ExpNear(exp, eps float64) func(got float64, errs ...error) {
 	return func(got float64, errs ...error) {
 		err := getErr(errs...)
@@ -152,6 +197,388 @@ func (m Myt) ExpNear(exp, eps float64) func(got float64, errs ...error) {
 	}
 }
 
+// Lt reports an error if got is not less than exp, or an optional non-nil error is provided.
+func (m Myt) Lt(exp, got interface{}, errs ...error) {
+	m.ExpLt(exp)(got, errs...)
+}
+
+// Le reports an error if got is not less than or equal to exp, or an optional non-nil error is provided.
+func (m Myt) Le(exp, got interface{}, errs ...error) {
+	m.ExpLe(exp)(got, errs...)
+}
+
+// Gt reports an error if got is not greater than exp, or an optional non-nil error is provided.
+func (m Myt) Gt(exp, got interface{}, errs ...error) {
+	m.ExpGt(exp)(got, errs...)
+}
+
+// Ge reports an error if got is not greater than or equal to exp, or an optional non-nil error is provided.
+func (m Myt) Ge(exp, got interface{}, errs ...error) {
+	m.ExpGe(exp)(got, errs...)
+}
+
+// Between reports an error if got is not in the inclusive range [min, max],
+// or an optional non-nil error is provided.
+func (m Myt) Between(min, max, got interface{}, errs ...error) {
+	err := getErr(errs...)
+	cMin, okMin := compare(got, min)
+	cMax, okMax := compare(got, max)
+	if !okMin || !okMax {
+		m.Errorf("%s\n\tCannot compare values: min: %v (%T), max: %v (%T), got: %v (%T)",
+			getFuncLine(), min, min, max, max, got, got)
+		return
+	}
+
+	if cMin >= 0 && cMax <= 0 && err == nil {
+		return
+	}
+
+	if err == nil {
+		m.Errorf("%s\n\tExpected got to be between %v and %v (inclusive), got: %v", getFuncLine(), min, max, got)
+	} else {
+		m.Errorf("%s\n\tExpected got to be between %v and %v (inclusive), got: %v, error: %v",
+			getFuncLine(), min, max, got, err)
+	}
+}
+
+// ExpLt takes the expected value and returns a function which
+// only takes the 'got' value and an optional error, reporting an error
+// if got is not less than exp.
+func (m Myt) ExpLt(exp interface{}) func(got interface{}, errs ...error) {
+	return m.expOrder(exp, "less than", func(c int) bool { return c < 0 })
+}
+
+// ExpLe takes the expected value and returns a function which
+// only takes the 'got' value and an optional error, reporting an error
+// if got is not less than or equal to exp.
+func (m Myt) ExpLe(exp interface{}) func(got interface{}, errs ...error) {
+	return m.expOrder(exp, "less than or equal to", func(c int) bool { return c <= 0 })
+}
+
+// ExpGt takes the expected value and returns a function which
+// only takes the 'got' value and an optional error, reporting an error
+// if got is not greater than exp.
+func (m Myt) ExpGt(exp interface{}) func(got interface{}, errs ...error) {
+	return m.expOrder(exp, "greater than", func(c int) bool { return c > 0 })
+}
+
+// ExpGe takes the expected value and returns a function which
+// only takes the 'got' value and an optional error, reporting an error
+// if got is not greater than or equal to exp.
+func (m Myt) ExpGe(exp interface{}) func(got interface{}, errs ...error) {
+	return m.expOrder(exp, "greater than or equal to", func(c int) bool { return c >= 0 })
+}
+
+// expOrder takes the expected value, a verb describing the relation for the
+// error message, and a predicate deciding if the result of compare(got, exp)
+// satisfies the relation. It returns a function which only takes the 'got'
+// value and an optional error.
+func (m Myt) expOrder(exp interface{}, verb string, want func(c int) bool) func(got interface{}, errs ...error) {
+	return func(got interface{}, errs ...error) {
+		err := getErr(errs...)
+		c, ok := compare(got, exp)
+		if !ok {
+			m.Errorf("%s\n\tCannot compare values: exp: %v (%T), got: %v (%T)", getFuncLine(), exp, exp, got, got)
+			return
+		}
+
+		if want(c) && err == nil {
+			return
+		}
+
+		if err == nil {
+			m.Errorf("%s\n\tExpected got to be %s %v, got: %v", getFuncLine(), verb, exp, got)
+		} else {
+			m.Errorf("%s\n\tExpected got to be %s %v, got: %v, error: %v", getFuncLine(), verb, exp, got, err)
+		}
+	}
+}
+
+// compare compares a and b and returns -1 if a < b, 0 if a == b and 1 if a > b.
+// ok is false if a and b are of mismatching or unorderable types or kinds,
+// or if either of them is a floating-point NaN, in which case result is 0.
+//
+// Supported kinds are the signed and unsigned integer kinds, both float kinds,
+// strings and time.Time (compared via Time.Before / Time.After).
+func compare(a, b interface{}) (result int, ok bool) {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return 0, false
+	}
+
+	if ta, isTime := a.(time.Time); isTime {
+		tb := b.(time.Time)
+		switch {
+		case ta.Before(tb):
+			return -1, true
+		case ta.After(tb):
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, y := va.Int(), vb.Int()
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x, y := va.Uint(), vb.Uint()
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case reflect.Float32, reflect.Float64:
+		x, y := va.Float(), vb.Float()
+		if math.IsNaN(x) || math.IsNaN(y) {
+			return 0, false
+		}
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case reflect.String:
+		x, y := va.String(), vb.String()
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		default:
+			return 0, true
+		}
+	default:
+		return 0, false
+	}
+}
+
+// Run wraps t.Run() / b.Run(), calling f with a fresh Myt wrapping the
+// subtest's / sub-benchmark's own *testing.T / *testing.B.
+// It panics if the underlying testing.TB is neither.
+func (m Myt) Run(name string, f func(m Myt)) {
+	switch tb := m.TB.(type) {
+	case *testing.T:
+		tb.Run(name, func(t *testing.T) { f(Myt{t}) })
+	case *testing.B:
+		tb.Run(name, func(b *testing.B) { f(Myt{b}) })
+	default:
+		panic(fmt.Sprintf("mighty: Run() requires a *testing.T or *testing.B, got: %T", m.TB))
+	}
+}
+
+// Parallel is a shortcut for t.Parallel(). It is a no-op if the underlying
+// testing.TB is not a *testing.T (e.g. it's a *testing.B).
+func (m Myt) Parallel() {
+	if t, ok := m.TB.(*testing.T); ok {
+		t.Parallel()
+	}
+}
+
+// Collector is a batching group of assertions acquired via Myt.Collect().
+// Its embedded Myt (and thus Eq, Neq, Deq, Near, ...) does not report
+// failures immediately; failures are recorded and only surfaced once
+// Flush() or Fatal() is called. This is handy inside loops where every
+// mismatch should be reported, not just the first one.
+type Collector struct {
+	Myt
+
+	// Individual, if set to true, makes Flush and Fatal report each
+	// recorded failure as its own call instead of joining them into
+	// a single message.
+	Individual bool
+
+	tb   testing.TB
+	msgs []string
+}
+
+// Collect returns a new *Collector which collects the failures of its
+// assertion methods instead of reporting them right away.
+func (m Myt) Collect() *Collector {
+	c := &Collector{tb: m.TB}
+	c.Myt = Myt{&collectingTB{TB: m.TB, c: c}}
+	return c
+}
+
+// collectingTB is a testing.TB whose Errorf appends to the owning
+// Collector instead of failing the test immediately.
+type collectingTB struct {
+	testing.TB
+	c *Collector
+}
+
+func (c *collectingTB) Errorf(format string, args ...interface{}) {
+	c.c.msgs = append(c.c.msgs, fmt.Sprintf(format, args...))
+}
+
+// Flush reports all failures recorded so far, then clears them: as a
+// single joined t.Errorf() call, or, if Individual is true, as one
+// t.Error() call per recorded failure.
+func (c *Collector) Flush() {
+	if len(c.msgs) == 0 {
+		return
+	}
+
+	if c.Individual {
+		for _, msg := range c.msgs {
+			c.tb.Error(msg)
+		}
+	} else {
+		c.tb.Errorf("%s", strings.Join(c.msgs, "\n"))
+	}
+	c.msgs = nil
+}
+
+// Fatal is like Flush, but reports the last (or only) recorded failure
+// using t.Fatal(), stopping the test / benchmark immediately.
+func (c *Collector) Fatal() {
+	if len(c.msgs) == 0 {
+		return
+	}
+
+	if c.Individual {
+		for _, msg := range c.msgs[:len(c.msgs)-1] {
+			c.tb.Error(msg)
+		}
+		c.tb.Fatal(c.msgs[len(c.msgs)-1])
+	} else {
+		c.tb.Fatal(strings.Join(c.msgs, "\n"))
+	}
+	c.msgs = nil
+}
+
+// Panics reports an error if f does not panic.
+func (m Myt) Panics(f func()) {
+	defer func() {
+		if recover() == nil {
+			m.Errorf("%s\n\tExpected f to panic, but it did not", getFuncLine())
+		}
+	}()
+	f()
+}
+
+// NotPanics reports an error if f panics.
+func (m Myt) NotPanics(f func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			m.Errorf("%s\n\tExpected f not to panic, but it panicked with: %v", getFuncLine(), r)
+		}
+	}()
+	f()
+}
+
+// PanicsWithValue reports an error if f does not panic, or if it panics
+// with a value that is not reflect.DeepEqual to exp.
+func (m Myt) PanicsWithValue(exp interface{}, f func()) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			m.Errorf("%s\n\tExpected f to panic with: %v, but it did not panic", getFuncLine(), exp)
+			return
+		}
+		if !reflect.DeepEqual(exp, r) {
+			m.Errorf("%s\n\tExpected f to panic with: %v, got: %v", getFuncLine(), exp, r)
+		}
+	}()
+	f()
+}
+
+// ErrorIs reports an error if errors.Is(got, target) is false.
+// On failure it also prints got's full unwrap chain.
+func (m Myt) ErrorIs(target, got error) {
+	if errors.Is(got, target) {
+		return
+	}
+	m.Errorf("%s\n\tExpected error chain to contain: %v\n\tGot error chain:\n%s",
+		getFuncLine(), target, unwrapChain(got))
+}
+
+// ErrorAs reports an error if errors.As(got, target) is false.
+// target must be a non-nil pointer, as required by errors.As.
+func (m Myt) ErrorAs(target interface{}, got error) {
+	if errors.As(got, target) {
+		return
+	}
+	m.Errorf("%s\n\tExpected error chain to contain an error assignable to: %v\n\tGot error chain:\n%s",
+		getFuncLine(), reflect.TypeOf(target).Elem(), unwrapChain(got))
+}
+
+// unwrapChain renders err and every error it wraps (following errors.Unwrap),
+// one per line, for use in ErrorIs / ErrorAs failure messages.
+func unwrapChain(err error) string {
+	if err == nil {
+		return "\t\t<nil>"
+	}
+
+	var sb strings.Builder
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		fmt.Fprintf(&sb, "\t\t%v\n", e)
+	}
+	return sb.String()
+}
+
+// Receives reports an error if ch does not deliver a value deeply-equal to
+// exp within timeout. ch must be a readable channel (chan T or <-chan T).
+func (m Myt) Receives(ch interface{}, exp interface{}, timeout time.Duration) {
+	got, ok, timedOut := recvWithTimeout(ch, timeout)
+	if timedOut {
+		m.Errorf("%s\n\tExpected a value on channel within %v, but timed out", getFuncLine(), timeout)
+		return
+	}
+	if !ok {
+		m.Errorf("%s\n\tExpected a value on channel, but it was closed", getFuncLine())
+		return
+	}
+	m.Deq(exp, got)
+}
+
+// Blocks reports an error if ch delivers a value, or is closed, within
+// timeout, i.e. it checks that ch is NOT ready to receive from.
+func (m Myt) Blocks(ch interface{}, timeout time.Duration) {
+	_, ok, timedOut := recvWithTimeout(ch, timeout)
+	if !timedOut {
+		if ok {
+			m.Errorf("%s\n\tExpected channel to block for %v, but it delivered a value", getFuncLine(), timeout)
+		} else {
+			m.Errorf("%s\n\tExpected channel to block for %v, but it was closed", getFuncLine(), timeout)
+		}
+	}
+}
+
+// recvWithTimeout tries to receive a value from the channel ch (which must
+// be a readable channel), waiting at most timeout. ok reports whether the
+// channel delivered a value (false if it was closed), timedOut reports
+// whether timeout elapsed before ch was ready.
+func recvWithTimeout(ch interface{}, timeout time.Duration) (got interface{}, ok, timedOut bool) {
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(time.After(timeout))},
+	}
+	chosen, v, recvOK := reflect.Select(cases)
+	if chosen == 1 {
+		return nil, false, true
+	}
+	if !recvOK {
+		return nil, false, false
+	}
+	return v.Interface(), true, false
+}
+
 // NearLogic is a variable holding a function which is responsible to
 // decide if 2 float64 numbers are near to each other (given an epsilon).
 // It is used by the Myt.Near() and Myt.ExpNear() functions.
@@ -163,7 +590,8 @@ var NearLogic = NearFunc
 // This is the default NearLogic, but you may set your own function.
 //
 // "near" is defined as the following:
-//     near := Math.Abs(a - b) < eps
+//
+//	near := Math.Abs(a - b) < eps
 //
 // Corner cases:
 //  1. if a==b, result is true (eps will not be checked, may be NaN)
@@ -180,6 +608,115 @@ func NearFunc(a, b, eps float64) bool {
 	return math.Abs(a-b) < eps
 }
 
+// NearRelFunc checks if 2 float64 numbers are "near" to each other using a
+// relative tolerance, which (unlike NearFunc) behaves well for very large
+// or very small magnitudes. You may assign this function to NearLogic to
+// use it as the default.
+//
+// "near" is defined as the following:
+//
+//	near := Math.Abs(a - b) <= eps * Math.Max(Math.Abs(a), Math.Abs(b))
+//
+// There is no absolute fallback for values close to zero: unless a == b, the
+// relative check above always decides, so e.g. 1e-10 and 2e-10 are not near
+// for any eps below 0.5.
+func NearRelFunc(a, b, eps float64) bool {
+	if a == b {
+		return true
+	}
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+
+	absA, absB := math.Abs(a), math.Abs(b)
+	largest := absA
+	if absB > largest {
+		largest = absB
+	}
+	if largest == 0 {
+		return NearFunc(a, b, eps)
+	}
+
+	return math.Abs(a-b) <= eps*largest
+}
+
+// NearULPFunc checks if 2 float64 numbers are "near" to each other, defined
+// as being at most ulps representable float64 values (ULPs, Units in the
+// Last Place) apart. You may use this to compare floats that went through
+// a chain of computations where an absolute or relative epsilon is hard to
+// reason about.
+//
+// Corner cases:
+//  1. if a==b, result is true (handles +0/-0 and the ulps=0 case)
+//  2. NaN is not near to anything (not even to NaN)
+//  3. equal-signed infinities are near to each other (consequence of 1.)
+func NearULPFunc(a, b float64, ulps uint64) bool {
+	if a == b {
+		return true
+	}
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+
+	ba, bb := ulpOrder(a), ulpOrder(b)
+	if ba > bb {
+		return ba-bb <= ulps
+	}
+	return bb-ba <= ulps
+}
+
+// ulpOrder converts the IEEE-754 bit pattern of a float64 into a biased,
+// monotonically increasing unsigned integer, so that plain unsigned
+// subtraction between the results of two calls gives the ULP distance
+// between the corresponding floats.
+func ulpOrder(f float64) uint64 {
+	const signBit = uint64(1) << 63
+
+	b := math.Float64bits(f)
+	if b&signBit != 0 {
+		// Negative: flip to a descending two's-complement-like order.
+		return ^b + 1
+	}
+	// Non-negative: shift up above the (flipped) negative range.
+	return signBit | b
+}
+
+// NearRel reports an error if the float64 exp is not "near" to got using a
+// relative tolerance (see NearRelFunc), or an optional non-nil error is
+// provided. Unlike Near, this always uses NearRelFunc regardless of the
+// NearLogic variable, letting you opt into relative tolerance on a
+// per-callsite basis.
+func (m Myt) NearRel(exp, got, eps float64, errs ...error) {
+	err := getErr(errs...)
+	if err == nil && NearRelFunc(exp, got, eps) {
+		return
+	}
+
+	if err == nil {
+		m.Errorf("%s\n\tExpected: %v, got: %v, with relative eps: %v", getFuncLine(), exp, got, eps)
+	} else {
+		m.Errorf("%s\n\tExpected: %v, got: %v, with relative eps: %v, error: %v", getFuncLine(), exp, got, eps, err)
+	}
+}
+
+// NearULP reports an error if the float64 exp is not within ulps
+// representable values of got (see NearULPFunc), or an optional non-nil
+// error is provided. Unlike Near, this always uses NearULPFunc regardless
+// of the NearLogic variable, letting you opt into ULP-based tolerance on a
+// per-callsite basis.
+func (m Myt) NearULP(exp, got float64, ulps uint64, errs ...error) {
+	err := getErr(errs...)
+	if err == nil && NearULPFunc(exp, got, ulps) {
+		return
+	}
+
+	if err == nil {
+		m.Errorf("%s\n\tExpected: %v, got: %v, with ulps: %v", getFuncLine(), exp, got, ulps)
+	} else {
+		m.Errorf("%s\n\tExpected: %v, got: %v, with ulps: %v, error: %v", getFuncLine(), exp, got, ulps, err)
+	}
+}
+
 // getErr is a utility function which returns the optional error
 // from the variadic errors.
 func getErr(errs ...error) error {
@@ -220,6 +757,10 @@ type Func2ArgsErr func(interface{}, interface{}, ...error)
 // and returns a function which takes 1 interface{} argument and an optional error (in the form of a variadic parameter).
 type Func1ArgFunc1ArgErr func(interface{}) func(interface{}, ...error)
 
+// Func3ArgsErr is a type describing a function which takes 3 interface{} arguments
+// and an optional error (in the form of a variadic parameter).
+type Func3ArgsErr func(interface{}, interface{}, interface{}, ...error)
+
 // Func3FloatsErr is a type describing a function which takes 3 float64 arguments
 // and an optional error (in the form of a variadic parameter).
 type Func3FloatsErr func(float64, float64, float64, ...error)
@@ -228,6 +769,10 @@ type Func3FloatsErr func(float64, float64, float64, ...error)
 // and returns a function which takes 1 float64 argument and an optional error (in the form of a variadic parameter).
 type Func2FloatsFunc1FloatErr func(float64, float64) func(float64, ...error)
 
+// Func2FloatsUintErr is a type describing a function which takes 2 float64 arguments,
+// a uint64 argument and an optional error (in the form of a variadic parameter).
+type Func2FloatsUintErr func(float64, float64, uint64, ...error)
+
 // Eq returns a method value of Myt{tb}.Eq.
 // tb may be a *testing.T or *testing.B value.
 func Eq(tb testing.TB) Func2ArgsErr {
@@ -252,6 +797,72 @@ func Near(tb testing.TB) Func3FloatsErr {
 	return Myt{tb}.Near
 }
 
+// NearRel returns a method value of Myt{tb}.NearRel.
+// tb may be a *testing.T or *testing.B value.
+func NearRel(tb testing.TB) Func3FloatsErr {
+	return Myt{tb}.NearRel
+}
+
+// NearULP returns a method value of Myt{tb}.NearULP.
+// tb may be a *testing.T or *testing.B value.
+func NearULP(tb testing.TB) Func2FloatsUintErr {
+	return Myt{tb}.NearULP
+}
+
+// Lt returns a method value of Myt{tb}.Lt.
+// tb may be a *testing.T or *testing.B value.
+func Lt(tb testing.TB) Func2ArgsErr {
+	return Myt{tb}.Lt
+}
+
+// Le returns a method value of Myt{tb}.Le.
+// tb may be a *testing.T or *testing.B value.
+func Le(tb testing.TB) Func2ArgsErr {
+	return Myt{tb}.Le
+}
+
+// Gt returns a method value of Myt{tb}.Gt.
+// tb may be a *testing.T or *testing.B value.
+func Gt(tb testing.TB) Func2ArgsErr {
+	return Myt{tb}.Gt
+}
+
+// Ge returns a method value of Myt{tb}.Ge.
+// tb may be a *testing.T or *testing.B value.
+func Ge(tb testing.TB) Func2ArgsErr {
+	return Myt{tb}.Ge
+}
+
+// Between returns a method value of Myt{tb}.Between.
+// tb may be a *testing.T or *testing.B value.
+func Between(tb testing.TB) Func3ArgsErr {
+	return Myt{tb}.Between
+}
+
+// ExpLt returns a method value of Myt{tb}.ExpLt.
+// tb may be a *testing.T or *testing.B value.
+func ExpLt(tb testing.TB) Func1ArgFunc1ArgErr {
+	return Myt{tb}.ExpLt
+}
+
+// ExpLe returns a method value of Myt{tb}.ExpLe.
+// tb may be a *testing.T or *testing.B value.
+func ExpLe(tb testing.TB) Func1ArgFunc1ArgErr {
+	return Myt{tb}.ExpLe
+}
+
+// ExpGt returns a method value of Myt{tb}.ExpGt.
+// tb may be a *testing.T or *testing.B value.
+func ExpGt(tb testing.TB) Func1ArgFunc1ArgErr {
+	return Myt{tb}.ExpGt
+}
+
+// ExpGe returns a method value of Myt{tb}.ExpGe.
+// tb may be a *testing.T or *testing.B value.
+func ExpGe(tb testing.TB) Func1ArgFunc1ArgErr {
+	return Myt{tb}.ExpGe
+}
+
 // EqNeq returns 2 method values: Myt{tb}.Eq and Myt{tb}.Neq.
 // tb may be a *testing.T or *testing.B value.
 func EqNeq(tb testing.TB) (Func2ArgsErr, Func2ArgsErr) {
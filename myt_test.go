@@ -2,8 +2,12 @@ package mighty
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 type TBMock struct {
@@ -16,6 +20,14 @@ func (m *TBMock) Errorf(format string, args ...interface{}) {
 	m.errCalls++
 }
 
+func (m *TBMock) Error(args ...interface{}) {
+	m.errCalls++
+}
+
+func (m *TBMock) Fatal(args ...interface{}) {
+	m.errCalls++
+}
+
 func TestMytDeqEqNeq(t *testing.T) {
 	tb := &TBMock{}
 	myt := Myt{tb}
@@ -27,11 +39,11 @@ func TestMytDeqEqNeq(t *testing.T) {
 		eqErrCalls, deqErrCalls, neqErrCalls int
 	}{
 		{1, 1, nil, 0, 0, 1},
-		{1, 2, nil, 1, 1, 0},
-		{1, "3", nil, 2, 2, 0},
+		{1, 2, nil, 1, 2, 0},
+		{1, "3", nil, 2, 3, 0},
 		{1, 1, errs, 1, 1, 1},
-		{1, 2, errs, 1, 1, 1},
-		{1, "3", errs, 2, 2, 1},
+		{1, 2, errs, 1, 2, 1},
+		{1, "3", errs, 2, 3, 1},
 	}
 
 	for i, c := range cases {
@@ -64,11 +76,11 @@ func TestMytDeq(t *testing.T) {
 		errCalls int
 	}{
 		{[]int{1, 2}, []int{1, 2}, nil, 0},
-		{[]int{1, 2}, []int{2, 2}, nil, 1},
-		{[]int{1, 2}, "x", nil, 2},
+		{[]int{1, 2}, []int{2, 2}, nil, 2},
+		{[]int{1, 2}, "x", nil, 3},
 		{[]int{1, 2}, []int{1, 2}, errs, 1},
-		{[]int{1, 2}, []int{2, 2}, errs, 1},
-		{[]int{1, 2}, "x", errs, 2},
+		{[]int{1, 2}, []int{2, 2}, errs, 2},
+		{[]int{1, 2}, "x", errs, 3},
 	}
 
 	for i, c := range cases {
@@ -80,6 +92,18 @@ func TestMytDeq(t *testing.T) {
 	}
 }
 
+func TestDiffFunc(t *testing.T) {
+	type point struct{ X, Y int }
+
+	diff := DiffFunc(point{1, 2}, point{1, 3})
+	if diff == "" {
+		t.Error("Expected a non-empty diff")
+	}
+	if strings.Contains(diff, "@@") == false {
+		t.Errorf("Expected a unified diff (with @@ hunk headers), got: %s", diff)
+	}
+}
+
 func TestMytNear(t *testing.T) {
 	tb := &TBMock{}
 	myt := Myt{tb}
@@ -113,6 +137,106 @@ func TestMytNear(t *testing.T) {
 	}
 }
 
+func TestMytOrderedCompare(t *testing.T) {
+	tb := &TBMock{}
+	myt := Myt{tb}
+
+	errs := []error{errors.New("test error")}
+	cases := []struct {
+		exp, got               interface{}
+		errs                   []error
+		ltErrCalls, leErrCalls int
+		gtErrCalls, geErrCalls int
+	}{
+		{1, 0, nil, 0, 0, 1, 1},
+		{1, 1, nil, 1, 0, 1, 0},
+		{1, 2, nil, 1, 1, 0, 0},
+		{"b", "a", nil, 0, 0, 1, 1},
+		{1, "x", nil, 1, 1, 1, 1}, // unorderable / mismatching types
+		{1, 0, errs, 1, 1, 1, 1},
+	}
+
+	for i, c := range cases {
+		tb.errCalls = 0
+		myt.Lt(c.exp, c.got, c.errs...)
+		if c.ltErrCalls != tb.errCalls {
+			t.Errorf("[i=%d] Lt: Expected: %d, got: %d", i, c.ltErrCalls, tb.errCalls)
+		}
+		tb.errCalls = 0
+		myt.Le(c.exp, c.got, c.errs...)
+		if c.leErrCalls != tb.errCalls {
+			t.Errorf("[i=%d] Le: Expected: %d, got: %d", i, c.leErrCalls, tb.errCalls)
+		}
+		tb.errCalls = 0
+		myt.Gt(c.exp, c.got, c.errs...)
+		if c.gtErrCalls != tb.errCalls {
+			t.Errorf("[i=%d] Gt: Expected: %d, got: %d", i, c.gtErrCalls, tb.errCalls)
+		}
+		tb.errCalls = 0
+		myt.Ge(c.exp, c.got, c.errs...)
+		if c.geErrCalls != tb.errCalls {
+			t.Errorf("[i=%d] Ge: Expected: %d, got: %d", i, c.geErrCalls, tb.errCalls)
+		}
+	}
+}
+
+func TestMytBetween(t *testing.T) {
+	tb := &TBMock{}
+	myt := Myt{tb}
+
+	errs := []error{errors.New("test error")}
+	cases := []struct {
+		min, max, got interface{}
+		errs          []error
+		errCalls      int
+	}{
+		{1, 10, 5, nil, 0},
+		{1, 10, 1, nil, 0},
+		{1, 10, 10, nil, 0},
+		{1, 10, 0, nil, 1},
+		{1, 10, 11, nil, 1},
+		{1, 10, "x", nil, 1},
+		{1, 10, 5, errs, 1},
+	}
+
+	for i, c := range cases {
+		tb.errCalls = 0
+		myt.Between(c.min, c.max, c.got, c.errs...)
+		if c.errCalls != tb.errCalls {
+			t.Errorf("[i=%d] Expected: %d, got: %d", i, c.errCalls, tb.errCalls)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		a, b  interface{}
+		exp   int
+		expOK bool
+	}{
+		{1, 2, -1, true},
+		{2, 1, 1, true},
+		{1, 1, 0, true},
+		{uint(1), uint(2), -1, true},
+		{1.0, 2.0, -1, true},
+		{"a", "b", -1, true},
+		{now, now.Add(time.Second), -1, true},
+		{now.Add(time.Second), now, 1, true},
+		{now, now, 0, true},
+		{1, "x", 0, false},
+		{1, 1.0, 0, false},
+		{struct{}{}, struct{}{}, 0, false},
+	}
+
+	for i, c := range cases {
+		result, ok := compare(c.a, c.b)
+		if ok != c.expOK || (ok && result != c.exp) {
+			t.Errorf("[i=%d] Expected: (%d, %v), got: (%d, %v)", i, c.exp, c.expOK, result, ok)
+		}
+	}
+}
+
 func TestFuncs(t *testing.T) {
 	tb := &TBMock{}
 
@@ -120,6 +244,13 @@ func TestFuncs(t *testing.T) {
 	Deq(tb)(1, 2)
 	Neq(tb)(1, 2)
 	Near(tb)(1, 1, 1e-6)
+	NearRel(tb)(1, 1, 1e-6)
+	NearULP(tb)(1, 1, 0)
+	Lt(tb)(1, 2)
+	Le(tb)(1, 2)
+	Gt(tb)(1, 2)
+	Ge(tb)(1, 2)
+	Between(tb)(1, 10, 5)
 
 	eq, neq := EqNeq(tb)
 	eq(1, 2)
@@ -129,6 +260,10 @@ func TestFuncs(t *testing.T) {
 	ExpDeq(tb)(1)(2, nil)
 	ExpNeq(tb)(1)(2, nil)
 	ExpNear(tb)(1, 1e-6)(1, nil)
+	ExpLt(tb)(1)(2, nil)
+	ExpLe(tb)(1)(2, nil)
+	ExpGt(tb)(1)(2, nil)
+	ExpGe(tb)(1)(2, nil)
 
 	eq, expEq := EqExpEq(tb)
 	eq(1, 2)
@@ -175,6 +310,342 @@ func TestNearLogic(t *testing.T) {
 	}
 }
 
+func TestNearRelFunc(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	cases := []struct {
+		a, b, eps float64
+		exp       bool
+	}{
+		{1.0, 1.0, 1e-9, true},
+		{0, 0, 1e-9, true},
+		{1e10, 1e10 + 1, 1e-6, true}, // Tiny relative difference for a large magnitude
+		{1e10, 1e10 + 1e6, 1e-6, false},
+		{1e-10, 2e-10, 1e-6, false}, // Near zero, relative tolerance breaks down
+		{1e-10, 1e-10 + 1e-15, 1e-2, true},
+		{1.0, nan, 1e10, false},
+		{nan, nan, 1e10, false},
+		{inf, inf, 1e-6, true},
+	}
+
+	for i, c := range cases {
+		if got := NearRelFunc(c.a, c.b, c.eps); c.exp != got {
+			t.Errorf("[i=%d] Expected: %v, got: %v", i, c.exp, got)
+		}
+	}
+}
+
+func TestNearULPFunc(t *testing.T) {
+	inf, neginf, nan := math.Inf(1), math.Inf(-1), math.NaN()
+	one := 1.0
+	oneNext := math.Nextafter(one, 2)
+	oneFar := math.Nextafter(oneNext, 2)
+
+	cases := []struct {
+		a, b float64
+		ulps uint64
+		exp  bool
+	}{
+		{one, one, 0, true},
+		{one, oneNext, 0, false},
+		{one, oneNext, 1, true},
+		{one, oneFar, 1, false},
+		{one, oneFar, 2, true},
+		{0, math.Copysign(0, -1), 0, true}, // +0 and -0
+		{1.0, nan, 10, false},
+		{nan, nan, 10, false},
+		{inf, inf, 0, true},
+		{neginf, neginf, 0, true},
+		{inf, neginf, 10, false},
+	}
+
+	for i, c := range cases {
+		if got := NearULPFunc(c.a, c.b, c.ulps); c.exp != got {
+			t.Errorf("[i=%d] Expected: %v, got: %v", i, c.exp, got)
+		}
+	}
+}
+
+func TestMytNearRelAndULP(t *testing.T) {
+	tb := &TBMock{}
+	myt := Myt{tb}
+
+	errs := []error{errors.New("test error")}
+
+	tb.errCalls = 0
+	myt.NearRel(1e10, 1e10+1, 1e-6)
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.NearRel(1e10, 1e10+1e6, 1e-6, errs...)
+	if tb.errCalls != 1 {
+		t.Errorf("Expected 1 error call, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.NearULP(1.0, math.Nextafter(1.0, 2), 1)
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.NearULP(1.0, 1.1, 1, errs...)
+	if tb.errCalls != 1 {
+		t.Errorf("Expected 1 error call, got: %d", tb.errCalls)
+	}
+}
+
+func TestCollector(t *testing.T) {
+	tb := &TBMock{}
+	myt := Myt{tb}
+
+	c := myt.Collect()
+	c.Eq(1, 2)
+	c.Eq(3, 3)
+	c.Eq(4, 5)
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no immediate Errorf calls, got: %d", tb.errCalls)
+	}
+
+	c.Flush()
+	if tb.errCalls != 1 {
+		t.Errorf("Expected 1 joined Errorf call, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	c.Flush() // No failures recorded anymore, must be a no-op.
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no Errorf call on empty Flush, got: %d", tb.errCalls)
+	}
+
+	c.Individual = true
+	c.Eq(1, 2)
+	c.Eq(3, 4)
+	tb.errCalls = 0
+	c.Flush()
+	if tb.errCalls != 2 {
+		t.Errorf("Expected 2 individual Error calls, got: %d", tb.errCalls)
+	}
+
+	c.Individual = false
+	c.Eq(1, 2)
+	c.Eq(3, 4)
+	tb.errCalls = 0
+	c.Fatal()
+	if tb.errCalls != 1 {
+		t.Errorf("Expected 1 joined Fatal call, got: %d", tb.errCalls)
+	}
+
+	c.Individual = true
+	c.Eq(1, 2)
+	c.Eq(3, 4)
+	tb.errCalls = 0
+	c.Fatal()
+	if tb.errCalls != 2 { // 1 Error call for all but the last, 1 Fatal call for the last.
+		t.Errorf("Expected 2 calls (Error+Fatal), got: %d", tb.errCalls)
+	}
+}
+
+func TestCollectorPromotesUnderlyingTB(t *testing.T) {
+	// Collect()'s Myt must still delegate promoted testing.TB methods other
+	// than Errorf (e.g. Helper, Name) to the real underlying TB instead of
+	// a nil one.
+	c := Myt{t}.Collect()
+	c.Helper()
+	if c.Name() != t.Name() {
+		t.Errorf("Expected Name(): %v, got: %v", t.Name(), c.Name())
+	}
+}
+
+func TestMytRun(t *testing.T) {
+	myt := Myt{t}
+
+	ran := false
+	myt.Run("sub", func(m Myt) {
+		ran = true
+		m.Eq(1, 1)
+	})
+	if !ran {
+		t.Error("Expected subtest to run")
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected Run() to panic for a non-*testing.T/*testing.B TB")
+			}
+		}()
+		Myt{&TBMock{}}.Run("sub", func(m Myt) {})
+	}()
+}
+
+func TestMytParallel(t *testing.T) {
+	Myt{t}.Run("parallel-sub", func(m Myt) {
+		m.Parallel()
+		m.Eq(1, 1)
+	})
+
+	// Must be a no-op for TBs that aren't *testing.T.
+	Myt{&TBMock{}}.Parallel()
+}
+
+func TestMytPanics(t *testing.T) {
+	tb := &TBMock{}
+	myt := Myt{tb}
+
+	tb.errCalls = 0
+	myt.Panics(func() { panic("boom") })
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error for a panicking f, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.Panics(func() {})
+	if tb.errCalls != 1 {
+		t.Errorf("Expected an error for a non-panicking f, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.NotPanics(func() {})
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error for a non-panicking f, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.NotPanics(func() { panic("boom") })
+	if tb.errCalls != 1 {
+		t.Errorf("Expected an error for a panicking f, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.PanicsWithValue("boom", func() { panic("boom") })
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error for a matching panic value, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.PanicsWithValue("boom", func() { panic("bang") })
+	if tb.errCalls != 1 {
+		t.Errorf("Expected an error for a mismatching panic value, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.PanicsWithValue("boom", func() {})
+	if tb.errCalls != 1 {
+		t.Errorf("Expected an error when f does not panic at all, got: %d", tb.errCalls)
+	}
+}
+
+func TestMytErrorIsAs(t *testing.T) {
+	tb := &TBMock{}
+	myt := Myt{tb}
+
+	sentinel := errors.New("sentinel")
+	wrapped := fmt.Errorf("context: %w", sentinel)
+
+	tb.errCalls = 0
+	myt.ErrorIs(sentinel, wrapped)
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error when target is in the chain, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.ErrorIs(errors.New("other"), wrapped)
+	if tb.errCalls != 1 {
+		t.Errorf("Expected an error when target is not in the chain, got: %d", tb.errCalls)
+	}
+
+	var pe *os.PathError
+	pathErr := &os.PathError{Op: "open", Path: "x", Err: sentinel}
+	wrappedPathErr := fmt.Errorf("context: %w", pathErr)
+
+	tb.errCalls = 0
+	myt.ErrorAs(&pe, wrappedPathErr)
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error when chain contains an assignable error, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.ErrorAs(&pe, sentinel)
+	if tb.errCalls != 1 {
+		t.Errorf("Expected an error when chain contains no assignable error, got: %d", tb.errCalls)
+	}
+}
+
+func TestMytChannelHelpers(t *testing.T) {
+	tb := &TBMock{}
+	myt := Myt{tb}
+
+	ch := make(chan int, 1)
+	ch <- 42
+
+	tb.errCalls = 0
+	myt.Receives(ch, 42, time.Second)
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error for a matching value, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.Receives(ch, 1, 10*time.Millisecond)
+	if tb.errCalls != 1 {
+		t.Errorf("Expected a timeout error, got: %d", tb.errCalls)
+	}
+
+	ch <- 1
+	tb.errCalls = 0
+	myt.Receives(ch, 2, time.Second)
+	if tb.errCalls != 2 { // 1 for the mismatch message, 1 for the Deq diff block.
+		t.Errorf("Expected a mismatch error, got: %d", tb.errCalls)
+	}
+
+	closedCh := make(chan int)
+	close(closedCh)
+	tb.errCalls = 0
+	myt.Receives(closedCh, 1, time.Second)
+	if tb.errCalls != 1 {
+		t.Errorf("Expected an error for a closed channel, got: %d", tb.errCalls)
+	}
+
+	// Slice and map payloads are not comparable with ==, so Receives must
+	// compare them with reflect.DeepEqual (i.e. not panic).
+	sliceCh := make(chan []int, 1)
+	sliceCh <- []int{1, 2, 3}
+	tb.errCalls = 0
+	myt.Receives(sliceCh, []int{1, 2, 3}, time.Second)
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error for a matching slice value, got: %d", tb.errCalls)
+	}
+
+	sliceCh <- []int{1, 2, 4}
+	tb.errCalls = 0
+	myt.Receives(sliceCh, []int{1, 2, 3}, time.Second)
+	if tb.errCalls != 2 { // 1 for the mismatch message, 1 for the Deq diff block.
+		t.Errorf("Expected a mismatch error for a differing slice value, got: %d", tb.errCalls)
+	}
+
+	blockingCh := make(chan int)
+	tb.errCalls = 0
+	myt.Blocks(blockingCh, 10*time.Millisecond)
+	if tb.errCalls != 0 {
+		t.Errorf("Expected no error for a blocking channel, got: %d", tb.errCalls)
+	}
+
+	readyCh := make(chan int, 1)
+	readyCh <- 1
+	tb.errCalls = 0
+	myt.Blocks(readyCh, time.Second)
+	if tb.errCalls != 1 {
+		t.Errorf("Expected an error for a channel that delivered a value, got: %d", tb.errCalls)
+	}
+
+	tb.errCalls = 0
+	myt.Blocks(closedCh, time.Second)
+	if tb.errCalls != 1 {
+		t.Errorf("Expected an error for a closed channel, got: %d", tb.errCalls)
+	}
+}
+
 func TestGetFileLineUnknown(t *testing.T) {
 	// We need a "deep" stack
 	var f func(int)